@@ -0,0 +1,264 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// taggedElem is the tagged wire format used to marshal a single list item,
+// e.g. {"t":"int64","v":42} or {"t":"mixed.List","v":[...]}.
+type taggedElem struct {
+	T string          `json:"t"`
+	V json.RawMessage `json:"v"`
+}
+
+// registry maps between registered sample types and the wire-format names
+// used to tag them, mirroring encoding/gob's type registry.
+var registry = struct {
+	sync.RWMutex
+	byName map[string]reflect.Type
+	byType map[reflect.Type]string
+}{
+	byName: make(map[string]reflect.Type),
+	byType: make(map[reflect.Type]string),
+}
+
+// Register associates a user type with a wire-format name, so Lists
+// containing values of that type can round-trip through MarshalJSON,
+// UnmarshalJSON, GobEncode and GobDecode with their concrete type intact.
+// sample must be a non-nil value of the type being registered; name must
+// be unique. Register panics if name is already registered to a different
+// type.
+func Register(sample interface{}, name string) {
+	t := reflect.TypeOf(sample)
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if existing, ok := registry.byName[name]; ok && existing != t {
+		panic(fmt.Sprintf("mixed: Register: name %q is already registered to %s", name, existing))
+	}
+
+	registry.byName[name] = t
+	registry.byType[t] = name
+	gob.Register(sample)
+}
+
+func init() {
+	Register(List{}, "mixed.List")
+}
+
+// builtinTag returns the wire-format tag name for the built-in scalar
+// types the loose decoder understands natively, or "" if x isn't one of
+// them.
+func builtinTag(x interface{}) string {
+	switch x.(type) {
+	case nil:
+		return "nil"
+	case bool:
+		return "bool"
+	case string:
+		return "string"
+	case int:
+		return "int"
+	case int8:
+		return "int8"
+	case int16:
+		return "int16"
+	case int32:
+		return "int32"
+	case int64:
+		return "int64"
+	case uint:
+		return "uint"
+	case uint8:
+		return "uint8"
+	case uint16:
+		return "uint16"
+	case uint32:
+		return "uint32"
+	case uint64:
+		return "uint64"
+	case float32:
+		return "float32"
+	case float64:
+		return "float64"
+	}
+	return ""
+}
+
+// MarshalJSON implements json.Marshaler, emitting each item tagged with
+// its concrete Go type so UnmarshalJSON can restore it exactly.
+func (lst List) MarshalJSON() ([]byte, error) {
+	elems := make([]taggedElem, len(lst))
+
+	for i, v := range lst {
+		tag := builtinTag(v)
+		if tag == "" {
+			registry.RLock()
+			name, ok := registry.byType[reflect.TypeOf(v)]
+			registry.RUnlock()
+			if !ok {
+				return nil, fmt.Errorf("mixed: MarshalJSON: type %T at index %d is not registered, call Register first", v, i)
+			}
+			tag = name
+		}
+
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("mixed: MarshalJSON: index %d: %w", i, err)
+		}
+
+		elems[i] = taggedElem{T: tag, V: raw}
+	}
+
+	return json.Marshal(elems)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It decodes the tagged wire
+// format produced by MarshalJSON, restoring each item's concrete Go type.
+// If an element has no "t" tag, it falls back to a loose decode using
+// json.Number/string/bool/nil/[]interface{}/map[string]interface{}, so
+// plain JSON arrays can still be loaded.
+func (lst *List) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("mixed: UnmarshalJSON: %w", err)
+	}
+
+	out := make(List, len(raw))
+	for i, r := range raw {
+		var elem taggedElem
+		if err := json.Unmarshal(r, &elem); err != nil || elem.T == "" {
+			v, err := looseDecode(r)
+			if err != nil {
+				return fmt.Errorf("mixed: UnmarshalJSON: index %d: %w", i, err)
+			}
+			out[i] = v
+			continue
+		}
+
+		v, err := decodeTagged(elem)
+		if err != nil {
+			return fmt.Errorf("mixed: UnmarshalJSON: index %d: %w", i, err)
+		}
+		out[i] = v
+	}
+
+	*lst = out
+	return nil
+}
+
+func decodeTagged(elem taggedElem) (interface{}, error) {
+	switch elem.T {
+	case "nil":
+		return nil, nil
+	case "bool":
+		var v bool
+		return v, json.Unmarshal(elem.V, &v)
+	case "string":
+		var v string
+		return v, json.Unmarshal(elem.V, &v)
+	case "int":
+		var v int
+		return v, json.Unmarshal(elem.V, &v)
+	case "int8":
+		var v int8
+		return v, json.Unmarshal(elem.V, &v)
+	case "int16":
+		var v int16
+		return v, json.Unmarshal(elem.V, &v)
+	case "int32":
+		var v int32
+		return v, json.Unmarshal(elem.V, &v)
+	case "int64":
+		var v int64
+		return v, json.Unmarshal(elem.V, &v)
+	case "uint":
+		var v uint
+		return v, json.Unmarshal(elem.V, &v)
+	case "uint8":
+		var v uint8
+		return v, json.Unmarshal(elem.V, &v)
+	case "uint16":
+		var v uint16
+		return v, json.Unmarshal(elem.V, &v)
+	case "uint32":
+		var v uint32
+		return v, json.Unmarshal(elem.V, &v)
+	case "uint64":
+		var v uint64
+		return v, json.Unmarshal(elem.V, &v)
+	case "float32":
+		var v float32
+		return v, json.Unmarshal(elem.V, &v)
+	case "float64":
+		var v float64
+		return v, json.Unmarshal(elem.V, &v)
+	}
+
+	registry.RLock()
+	t, ok := registry.byName[elem.T]
+	registry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("type tag %q is not registered, call Register first", elem.T)
+	}
+
+	ptr := reflect.New(t)
+	if err := json.Unmarshal(elem.V, ptr.Interface()); err != nil {
+		return nil, err
+	}
+	return ptr.Elem().Interface(), nil
+}
+
+// looseDecode decodes a single JSON value using encoding/json's default
+// untyped representation: json.Number, string, bool, nil, []interface{}
+// or map[string]interface{}.
+func looseDecode(raw json.RawMessage) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GobEncode implements gob.GobEncoder, emitting each item wrapped in an
+// interface{} so encoding/gob's own type registry (populated by Register)
+// preserves the concrete type on decode.
+func (lst List) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+
+	items := make([]interface{}, len(lst))
+	copy(items, lst)
+
+	if err := enc.Encode(items); err != nil {
+		return nil, fmt.Errorf("mixed: GobEncode: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, restoring a list encoded with
+// GobEncode.
+func (lst *List) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+
+	var items []interface{}
+	if err := dec.Decode(&items); err != nil {
+		return fmt.Errorf("mixed: GobDecode: %w", err)
+	}
+
+	*lst = List(items)
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJSONRoundTripPreservesTypes(t *testing.T) {
+	in := List{1, "two", 3.14, List{true}}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out List
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("round trip mismatch: in = %#v, out = %#v", in, out)
+	}
+}
+
+type point struct {
+	X, Y int
+}
+
+func TestJSONRoundTripRegisteredType(t *testing.T) {
+	Register(point{}, "mixed_test.point")
+
+	in := List{point{1, 2}, "plain"}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out List
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("round trip mismatch: in = %#v, out = %#v", in, out)
+	}
+}
+
+func TestJSONUnmarshalLooseFallback(t *testing.T) {
+	var out List
+	if err := json.Unmarshal([]byte(`[1, "two", true, null, [1,2], {"a":1}]`), &out); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if len(out) != 6 {
+		t.Fatalf("len(out) = %d, want 6", len(out))
+	}
+
+	if _, ok := out[0].(json.Number); !ok {
+		t.Errorf("out[0] = %T, want json.Number", out[0])
+	}
+	if _, ok := out[4].([]interface{}); !ok {
+		t.Errorf("out[4] = %T, want []interface{}", out[4])
+	}
+	if _, ok := out[5].(map[string]interface{}); !ok {
+		t.Errorf("out[5] = %T, want map[string]interface{}", out[5])
+	}
+}
+
+func TestGobRoundTripPreservesTypes(t *testing.T) {
+	in := List{1, "two", 3.14, List{true}}
+
+	data, err := in.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode: %v", err)
+	}
+
+	var out List
+	if err := out.GobDecode(data); err != nil {
+		t.Fatalf("GobDecode: %v", err)
+	}
+
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("round trip mismatch: in = %#v, out = %#v", in, out)
+	}
+}
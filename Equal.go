@@ -0,0 +1,326 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// equalConfig holds the resolved set of EqualOption values for a single
+// Equal/Diff call.
+type equalConfig struct {
+	ignoreCase   bool
+	ignoreOrder  bool
+	equateEmpty  bool
+	approxFrac   float64
+	approxMargin float64
+	useApprox    bool
+	ignoreIdx    map[int]bool
+	comparers    map[reflect.Type]reflect.Value
+}
+
+// EqualOption configures a call to Equal or Diff. Options are applied in
+// the order they're passed, modeled on the functional-options pattern used
+// by google/go-cmp.
+type EqualOption func(*equalConfig)
+
+// IgnoreCase makes string comparisons case-insensitive.
+func IgnoreCase() EqualOption {
+	return func(c *equalConfig) {
+		c.ignoreCase = true
+	}
+}
+
+// IgnoreOrder compares the two lists as multisets rather than sequences,
+// so two lists containing the same items in a different order are equal.
+func IgnoreOrder() EqualOption {
+	return func(c *equalConfig) {
+		c.ignoreOrder = true
+	}
+}
+
+// EquateEmpty treats a nil list/slice/map and a zero-length one as equal.
+func EquateEmpty() EqualOption {
+	return func(c *equalConfig) {
+		c.equateEmpty = true
+	}
+}
+
+// EquateApprox compares float32/float64 values for approximate equality.
+// Two values x and y are considered equal if they're within margin of each
+// other, or within fraction of the larger of the two magnitudes.
+func EquateApprox(fraction, margin float64) EqualOption {
+	return func(c *equalConfig) {
+		c.useApprox = true
+		c.approxFrac = fraction
+		c.approxMargin = margin
+	}
+}
+
+// IgnoreIndexes skips the given list indexes when comparing.
+func IgnoreIndexes(indexes ...int) EqualOption {
+	return func(c *equalConfig) {
+		if c.ignoreIdx == nil {
+			c.ignoreIdx = make(map[int]bool, len(indexes))
+		}
+		for _, idx := range indexes {
+			c.ignoreIdx[idx] = true
+		}
+	}
+}
+
+// Comparer registers a custom equality function for a specific type. fn
+// must be a func(T, T) bool for some type T; it's dispatched by the
+// dynamic type of the values being compared, the same way go-cmp does it.
+// Comparer panics if fn is not a func(T, T) bool.
+func Comparer(fn interface{}) EqualOption {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 1 ||
+		t.In(0) != t.In(1) || t.Out(0).Kind() != reflect.Bool {
+		panic("mixed: Comparer: fn must be a func(T, T) bool")
+	}
+
+	return func(c *equalConfig) {
+		if c.comparers == nil {
+			c.comparers = make(map[reflect.Type]reflect.Value)
+		}
+		c.comparers[t.In(0)] = v
+	}
+}
+
+func newEqualConfig(opts []EqualOption) *equalConfig {
+	c := &equalConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Equal compares two lists for equality according to the given options.
+// With no options it falls back to reflect.DeepEqual on corresponding
+// items, recursing into nested List values with the same option set.
+func Equal(a, b List, opts ...EqualOption) bool {
+	return newEqualConfig(opts).equalLists(a, b)
+}
+
+func (c *equalConfig) equalLists(a, b List) bool {
+	if c.equateEmpty && len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	if c.ignoreOrder {
+		return c.equalUnordered(a, b)
+	}
+
+	ai, bi := c.visibleIndexes(len(a)), c.visibleIndexes(len(b))
+	if len(ai) != len(bi) {
+		return false
+	}
+
+	for i := range ai {
+		if !c.equalItems(a[ai[i]], b[bi[i]]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// visibleIndexes returns the indexes of size n that aren't excluded by
+// IgnoreIndexes, in order.
+func (c *equalConfig) visibleIndexes(n int) []int {
+	idxs := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if !c.ignoreIdx[i] {
+			idxs = append(idxs, i)
+		}
+	}
+	return idxs
+}
+
+func (c *equalConfig) equalUnordered(a, b List) bool {
+	ai, bi := c.visibleIndexes(len(a)), c.visibleIndexes(len(b))
+	if len(ai) != len(bi) {
+		return false
+	}
+
+	used := make([]bool, len(bi))
+	for _, i := range ai {
+		matched := false
+		for j, bj := range bi {
+			if used[j] {
+				continue
+			}
+			if c.equalItems(a[i], b[bj]) {
+				used[j] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *equalConfig) equalItems(x, y interface{}) bool {
+	// A registered Comparer always takes priority, even over the built-in
+	// List recursion below, so a Comparer(func(x, y List) bool) can
+	// override how nested lists are compared.
+	tx := reflect.TypeOf(x)
+	if tx != nil {
+		if cmp, ok := c.comparers[tx]; ok {
+			ty := reflect.TypeOf(y)
+			if tx != ty {
+				return false
+			}
+			out := cmp.Call([]reflect.Value{reflect.ValueOf(x), reflect.ValueOf(y)})
+			return out[0].Bool()
+		}
+	}
+
+	if lx, ok := x.(List); ok {
+		ly, ok := y.(List)
+		if !ok {
+			return false
+		}
+		return c.equalLists(lx, ly)
+	}
+
+	if c.ignoreCase {
+		sx, okx := x.(string)
+		sy, oky := y.(string)
+		if okx && oky {
+			return strings.EqualFold(sx, sy)
+		}
+	}
+
+	if c.useApprox {
+		fx, okx := toFloat(x)
+		fy, oky := toFloat(y)
+		if okx && oky {
+			return approxEqual(fx, fy, c.approxFrac, c.approxMargin)
+		}
+	}
+
+	if c.equateEmpty {
+		if isEmptyContainer(x) && isEmptyContainer(y) {
+			return true
+		}
+	}
+
+	return reflect.DeepEqual(x, y)
+}
+
+// toFloat converts x to a float64 if it's one of the float families.
+func toFloat(x interface{}) (float64, bool) {
+	switch v := x.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func approxEqual(x, y, fraction, margin float64) bool {
+	if x == y {
+		return true
+	}
+
+	diff := math.Abs(x - y)
+	if diff <= margin {
+		return true
+	}
+
+	maxAbs := math.Max(math.Abs(x), math.Abs(y))
+	return diff <= maxAbs*fraction
+}
+
+// isEmptyContainer reports whether x is a nil or zero-length slice or map.
+func isEmptyContainer(x interface{}) bool {
+	if x == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+
+	return false
+}
+
+// Equals compares the list against another list for equality.
+// Returns a bool, true denoting equal lists, and false denoting unequal lists
+func (lst List) Equals(list List) bool {
+	return Equal(lst, list)
+}
+
+// EqualsIgnoreCase similar to Equals however, when comparing strings it ignores case
+func (lst List) EqualsIgnoreCase(list List) bool {
+	return Equal(lst, list, IgnoreCase())
+}
+
+// maxDiffLines caps the number of differences Diff reports, so a diff
+// between two wildly different lists doesn't produce an unreadable wall
+// of text.
+const maxDiffLines = 20
+
+// Diff returns a human-readable diff between a and b, describing up to the
+// first maxDiffLines differences found. Differences are reported as
+// "- a[i]: ..." and "+ b[i]: ..." lines; an empty string means a and b are
+// equal under opts.
+func Diff(a, b List, opts ...EqualOption) string {
+	c := newEqualConfig(opts)
+	if c.equalLists(a, b) {
+		return ""
+	}
+
+	var lines []string
+	ai, bi := c.visibleIndexes(len(a)), c.visibleIndexes(len(b))
+	max := len(ai)
+	if len(bi) > max {
+		max = len(bi)
+	}
+
+	diffs := 0
+	for i := 0; i < max && diffs < maxDiffLines; i++ {
+		var av, bv interface{}
+		var aok, bok bool
+
+		if i < len(ai) {
+			av, aok = a[ai[i]], true
+		}
+		if i < len(bi) {
+			bv, bok = b[bi[i]], true
+		}
+
+		switch {
+		case aok && bok && c.equalItems(av, bv):
+			continue
+		}
+
+		diffs++
+		switch {
+		case aok && bok:
+			lines = append(lines, fmt.Sprintf("- a[%d]: %v", ai[i], av))
+			lines = append(lines, fmt.Sprintf("+ b[%d]: %v", bi[i], bv))
+		case aok:
+			lines = append(lines, fmt.Sprintf("- a[%d]: %v", ai[i], av))
+		case bok:
+			lines = append(lines, fmt.Sprintf("+ b[%d]: %v", bi[i], bv))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
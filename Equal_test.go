@@ -0,0 +1,141 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import "testing"
+
+func TestEqualDefault(t *testing.T) {
+	a := List{1, "two", 3.0}
+	b := List{1, "two", 3.0}
+	c := List{1, "two", 4.0}
+
+	if !Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = false, want true", a, b)
+	}
+
+	if Equal(a, c) {
+		t.Errorf("Equal(%v, %v) = true, want false", a, c)
+	}
+}
+
+func TestEqualNestedList(t *testing.T) {
+	a := List{List{1, 2}, "x"}
+	b := List{List{1, 2}, "x"}
+	c := List{List{1, 3}, "x"}
+
+	if !Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = false, want true", a, b)
+	}
+
+	if Equal(a, c) {
+		t.Errorf("Equal(%v, %v) = true, want false", a, c)
+	}
+}
+
+func TestEqualIgnoreCase(t *testing.T) {
+	a := List{"Hello", "World"}
+	b := List{"hello", "WORLD"}
+
+	if !Equal(a, b, IgnoreCase()) {
+		t.Errorf("Equal(%v, %v, IgnoreCase()) = false, want true", a, b)
+	}
+
+	if Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = true, want false", a, b)
+	}
+}
+
+func TestEqualIgnoreOrder(t *testing.T) {
+	a := List{1, 2, 3}
+	b := List{3, 1, 2}
+
+	if !Equal(a, b, IgnoreOrder()) {
+		t.Errorf("Equal(%v, %v, IgnoreOrder()) = false, want true", a, b)
+	}
+
+	if Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = true, want false", a, b)
+	}
+}
+
+func TestEquateEmpty(t *testing.T) {
+	// Two zero-length lists are trivially equal regardless of EquateEmpty;
+	// the option matters for nested empty containers stored as items.
+	a := List{[]int(nil)}
+	b := List{[]int{}}
+
+	if Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = true, want false without EquateEmpty", a, b)
+	}
+
+	if !Equal(a, b, EquateEmpty()) {
+		t.Errorf("Equal(%v, %v, EquateEmpty()) = false, want true", a, b)
+	}
+}
+
+func TestEquateApprox(t *testing.T) {
+	a := List{1.0}
+	b := List{1.0001}
+
+	if Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = true, want false without EquateApprox", a, b)
+	}
+
+	if !Equal(a, b, EquateApprox(0, 0.001)) {
+		t.Errorf("Equal(%v, %v, EquateApprox(0, 0.001)) = false, want true", a, b)
+	}
+}
+
+func TestIgnoreIndexes(t *testing.T) {
+	a := List{1, 2, 3}
+	b := List{1, 99, 3}
+
+	if Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = true, want false without IgnoreIndexes", a, b)
+	}
+
+	if !Equal(a, b, IgnoreIndexes(1)) {
+		t.Errorf("Equal(%v, %v, IgnoreIndexes(1)) = false, want true", a, b)
+	}
+}
+
+// TestComparerOverridesNestedList ensures a registered Comparer for List
+// takes priority over the built-in List recursion in equalItems.
+func TestComparerOverridesNestedList(t *testing.T) {
+	a := List{List{1, 2}}
+	b := List{List{9, 9, 9}}
+
+	alwaysEqual := Comparer(func(x, y List) bool { return true })
+
+	if !Equal(a, b, alwaysEqual) {
+		t.Errorf("Equal(%v, %v, Comparer) = false, want true", a, b)
+	}
+}
+
+func TestComparer(t *testing.T) {
+	type point struct{ x, y int }
+
+	a := List{point{1, 2}}
+	b := List{point{1, 3}}
+
+	sameX := Comparer(func(p, q point) bool { return p.x == q.x })
+
+	if !Equal(a, b, sameX) {
+		t.Errorf("Equal(%v, %v, Comparer) = false, want true", a, b)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := List{1, 2, 3}
+	b := List{1, 99, 3}
+
+	if d := Diff(a, a); d != "" {
+		t.Errorf("Diff(a, a) = %q, want empty", d)
+	}
+
+	d := Diff(a, b)
+	if d == "" {
+		t.Errorf("Diff(%v, %v) = empty, want a description of the mismatch", a, b)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import (
+	"iter"
+	"sort"
+)
+
+// All returns an iterator over the list's index/item pairs, in order,
+// suitable for use in a range-over-func loop: for i, v := range lst.All().
+//
+// Note: All is the range-over-func iterator, not a predicate check. A
+// predicate "do all items match?" method would naturally also be called
+// All, but Go doesn't support overloading, so that one is named AllMatch
+// below; this is a deliberate naming choice, not an oversight.
+func (lst List) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i, v := range lst {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the list's items, in order, discarding
+// their indexes.
+func (lst List) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, v := range lst {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the list's index/item pairs in
+// reverse order.
+func (lst List) Backward() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for i := len(lst) - 1; i >= 0; i-- {
+			if !yield(i, lst[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FilterFunc returns a new list containing only the items for which pred
+// returns true.
+func (lst List) FilterFunc(pred func(interface{}) bool) List {
+	out := List{}
+	for _, v := range lst {
+		if pred(v) {
+			out.Append(v)
+		}
+	}
+	return out
+}
+
+// MapFunc returns a new list with each item replaced by the result of
+// calling fn on it.
+func (lst List) MapFunc(fn func(interface{}) interface{}) List {
+	out := make(List, len(lst))
+	for i, v := range lst {
+		out[i] = fn(v)
+	}
+	return out
+}
+
+// Any reports whether pred returns true for at least one item in the
+// list.
+func (lst List) Any(pred func(interface{}) bool) bool {
+	for _, v := range lst {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch reports whether pred returns true for every item in the list.
+// It's named AllMatch, rather than All, to avoid colliding with the All
+// range-over-func iterator above.
+func (lst List) AllMatch(pred func(interface{}) bool) bool {
+	for _, v := range lst {
+		if !pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of items for which pred returns true.
+func (lst List) Count(pred func(interface{}) bool) int {
+	n := 0
+	for _, v := range lst {
+		if pred(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Partition splits the list in two: the items for which pred returns
+// true, and the items for which it returns false, each preserving order.
+func (lst List) Partition(pred func(interface{}) bool) (List, List) {
+	t, f := List{}, List{}
+	for _, v := range lst {
+		if pred(v) {
+			t.Append(v)
+		} else {
+			f.Append(v)
+		}
+	}
+	return t, f
+}
+
+// Sort sorts the list in place using less to compare items. The sort is
+// not guaranteed to be stable; use SortStable if that matters.
+func (lst List) Sort(less func(a, b interface{}) bool) {
+	sort.Slice(lst, func(i, j int) bool {
+		return less(lst[i], lst[j])
+	})
+}
+
+// SortStable sorts the list in place using less to compare items,
+// preserving the relative order of items that compare equal.
+func (lst List) SortStable(less func(a, b interface{}) bool) {
+	sort.SliceStable(lst, func(i, j int) bool {
+		return less(lst[i], lst[j])
+	})
+}
+
+// Unique returns a new list with duplicate items removed, keeping the
+// first occurrence of each. eq reports whether two items are equal.
+func (lst List) Unique(eq func(a, b interface{}) bool) List {
+	out := List{}
+	for _, v := range lst {
+		if !out.Any(func(o interface{}) bool { return eq(v, o) }) {
+			out.Append(v)
+		}
+	}
+	return out
+}
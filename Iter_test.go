@@ -0,0 +1,118 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import "testing"
+
+func intLess(a, b interface{}) bool {
+	return a.(int) < b.(int)
+}
+
+func TestAllIterator(t *testing.T) {
+	lst := List{10, 20, 30}
+
+	var idxs []int
+	var vals []interface{}
+	for i, v := range lst.All() {
+		idxs = append(idxs, i)
+		vals = append(vals, v)
+	}
+
+	if len(idxs) != 3 || idxs[0] != 0 || idxs[2] != 2 {
+		t.Errorf("All() indexes = %v, want [0 1 2]", idxs)
+	}
+	if len(vals) != 3 || vals[1] != 20 {
+		t.Errorf("All() values = %v, want [10 20 30]", vals)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	lst := List{1, 2, 3}
+
+	var vals []interface{}
+	for _, v := range lst.Backward() {
+		vals = append(vals, v)
+	}
+
+	if len(vals) != 3 || vals[0] != 3 || vals[2] != 1 {
+		t.Errorf("Backward() values = %v, want [3 2 1]", vals)
+	}
+}
+
+func TestAnyAndAllMatch(t *testing.T) {
+	lst := List{2, 4, 6}
+
+	isEven := func(v interface{}) bool { return v.(int)%2 == 0 }
+	isOdd := func(v interface{}) bool { return v.(int)%2 != 0 }
+
+	if !lst.AllMatch(isEven) {
+		t.Error("AllMatch(isEven) = false, want true")
+	}
+	if lst.Any(isOdd) {
+		t.Error("Any(isOdd) = true, want false")
+	}
+
+	lst.Append(7)
+	if lst.AllMatch(isEven) {
+		t.Error("AllMatch(isEven) = true after appending an odd value, want false")
+	}
+	if !lst.Any(isOdd) {
+		t.Error("Any(isOdd) = false after appending an odd value, want true")
+	}
+}
+
+func TestCountAndPartition(t *testing.T) {
+	lst := List{1, 2, 3, 4, 5}
+	isEven := func(v interface{}) bool { return v.(int)%2 == 0 }
+
+	if n := lst.Count(isEven); n != 2 {
+		t.Errorf("Count(isEven) = %d, want 2", n)
+	}
+
+	evens, odds := lst.Partition(isEven)
+	if !Equal(evens, List{2, 4}) {
+		t.Errorf("Partition evens = %v, want [2 4]", evens)
+	}
+	if !Equal(odds, List{1, 3, 5}) {
+		t.Errorf("Partition odds = %v, want [1 3 5]", odds)
+	}
+}
+
+func TestSort(t *testing.T) {
+	lst := List{3, 1, 2}
+	lst.Sort(intLess)
+
+	if !Equal(lst, List{1, 2, 3}) {
+		t.Errorf("Sort = %v, want [1 2 3]", lst)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	type pair struct {
+		key, seq int
+	}
+
+	lst := List{pair{1, 0}, pair{0, 1}, pair{1, 2}, pair{0, 3}}
+	lst.SortStable(func(a, b interface{}) bool {
+		return a.(pair).key < b.(pair).key
+	})
+
+	want := []int{1, 3, 0, 2}
+	for i, seq := range want {
+		if lst[i].(pair).seq != seq {
+			t.Errorf("SortStable[%d] = %v, want seq %d", i, lst[i], seq)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	lst := List{1, 2, 1, 3, 2, 1}
+
+	eq := func(a, b interface{}) bool { return a.(int) == b.(int) }
+	out := lst.Unique(eq)
+
+	if !Equal(out, List{1, 2, 3}) {
+		t.Errorf("Unique = %v, want [1 2 3]", out)
+	}
+}
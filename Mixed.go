@@ -6,7 +6,6 @@ package mixed
 import (
 	"errors"
 	"fmt"
-	"strings"
 )
 
 // IndexOutOfBounds indicates that an given index has exceeded
@@ -109,45 +108,10 @@ func (lst List) Copy() List {
 	return cpy
 }
 
-// Equals compares the list against another list for equality.
-// Returns a bool, true denoting equal lists, and false denoting unequal lists
-func (lst List) Equals(list List) bool {
-	if len(lst) != len(list) {
-		return false
-	}
-
-	for i := 0; i < len(lst); i++ {
-		// negative check to end the for loop as soon as an inequality is found
-		if lst[i] != list[i] {
-			return false
-		}
-	}
-
-	return true
-}
-
-// EqualsIgnoreCase similar to Equals however, when comparing strings it ignores case
-func (lst List) EqualsIgnoreCase(list List) bool {
-	if len(lst) != len(list) {
-		return false
-	}
-
-	for i := 0; i < len(lst); i++ {
-		// comma, ok pattern to type check that the items are strings
-		valA, okA := lst[i].(string)
-		valB, okB := list[i].(string)
-
-		// if both items are strings perform ToLower to equalize the case
-		if okA && okB {
-			valA = strings.ToLower(valA)
-			valB = strings.ToLower(valB)
-		}
-
-		// negative check to end the for loop as soon as an inequality is found
-		if valA != valB {
-			return false
-		}
-	}
-
-	return true
+// Slice returns the []interface{} backing the list. The returned slice
+// shares storage with lst, so it's meant for read-only iteration by
+// callers (such as the mixed/typed subpackage and List.Stats) that need
+// to walk the items without the overhead of re-wrapping each one.
+func (lst List) Slice() []interface{} {
+	return lst
 }
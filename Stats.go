@@ -0,0 +1,14 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package mixed
+
+import "github.com/HOWZ1T/MixedLists/stats"
+
+// Stats computes the scalar numeric aggregates (sum, mean, min, max,
+// variance, standard deviation, median) over the numeric elements of the
+// list in a single pass, ignoring non-numeric elements. See the stats
+// package for the individual aggregates and a streaming EWMA.
+func (lst List) Stats() stats.Summary {
+	return stats.Summarize(lst.Slice())
+}
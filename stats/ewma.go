@@ -0,0 +1,61 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package stats
+
+// defaultWarmup is the smallest warmup count an EWMA uses: NewEWMA raises
+// any smaller warmup (such as 0) to it.
+const defaultWarmup = 1
+
+// EWMA is a streaming exponentially weighted moving average, suited to
+// telemetry buffers where samples arrive one at a time. The first warmup
+// samples are averaged arithmetically; after that, Add transitions to the
+// recurrence avg = avg + alpha*(x-avg).
+type EWMA struct {
+	alpha  float64
+	warmup int
+	n      int
+	avg    float64
+}
+
+// NewEWMA returns an EWMA with the given decay (the N in
+// alpha = 2/(N+1)) and warmup sample count. warmup is clamped to at
+// least 1: the very first sample always has to seed the average
+// arithmetically, since the exponential recurrence has no prior average
+// to work from, so a warmup of 0 behaves the same as 1 - just the first
+// sample seeds the average, and every sample after that uses the
+// exponential recurrence.
+func NewEWMA(decay float64, warmup int) *EWMA {
+	if warmup < defaultWarmup {
+		warmup = defaultWarmup
+	}
+
+	return &EWMA{
+		alpha:  2 / (decay + 1),
+		warmup: warmup,
+	}
+}
+
+// Add feeds a new sample into the average.
+func (e *EWMA) Add(x float64) {
+	e.n++
+
+	if e.n <= e.warmup {
+		e.avg += (x - e.avg) / float64(e.n)
+		return
+	}
+
+	e.avg += e.alpha * (x - e.avg)
+}
+
+// Value returns the current average. It's 0 until the first sample is
+// added.
+func (e *EWMA) Value() float64 {
+	return e.avg
+}
+
+// Valid reports whether enough samples have been added for the average
+// to have left its warmup phase.
+func (e *EWMA) Valid() bool {
+	return e.n >= e.warmup
+}
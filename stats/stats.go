@@ -0,0 +1,222 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+
+// Package stats provides numeric aggregates over the numeric subset of a
+// mixed.List - the elements matching one of Go's int/uint/float families -
+// plus a streaming EWMA for telemetry-style buffers. Functions here take
+// a plain []interface{} (as returned by List.Slice) rather than a
+// mixed.List, so this package doesn't need to import mixed and List.Stats
+// can depend on stats without an import cycle.
+package stats
+
+import (
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Summary holds the scalar aggregates computed over the numeric elements
+// of a list, as returned by List.Stats.
+type Summary struct {
+	Count    int
+	Sum      float64
+	Mean     float64
+	Min      float64
+	Max      float64
+	Variance float64
+	StdDev   float64
+	Median   float64
+}
+
+// values extracts the numeric elements of items as float64, ignoring any
+// element that isn't an int/uint/float family type.
+func values(items []interface{}) []float64 {
+	out := make([]float64, 0, len(items))
+	for _, v := range items {
+		if f, ok := toFloat(v); ok {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// toFloat converts x to a float64 if its kind is one of Go's int/uint/float
+// kinds. Named types with such a kind (time.Duration, for example) count
+// as numeric too.
+func toFloat(x interface{}) (float64, bool) {
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// Sum returns the sum of the numeric elements of items.
+func Sum(items []interface{}) float64 {
+	var total float64
+	for _, f := range values(items) {
+		total += f
+	}
+	return total
+}
+
+// Mean returns the arithmetic mean of the numeric elements of items, or 0
+// if items has no numeric elements.
+func Mean(items []interface{}) float64 {
+	vs := values(items)
+	if len(vs) == 0 {
+		return 0
+	}
+	return Sum(items) / float64(len(vs))
+}
+
+// Min returns the smallest numeric element of items, or (0, false) if
+// items has no numeric elements.
+func Min(items []interface{}) (float64, bool) {
+	vs := values(items)
+	if len(vs) == 0 {
+		return 0, false
+	}
+
+	min := vs[0]
+	for _, f := range vs[1:] {
+		if f < min {
+			min = f
+		}
+	}
+	return min, true
+}
+
+// Max returns the largest numeric element of items, or (0, false) if
+// items has no numeric elements.
+func Max(items []interface{}) (float64, bool) {
+	vs := values(items)
+	if len(vs) == 0 {
+		return 0, false
+	}
+
+	max := vs[0]
+	for _, f := range vs[1:] {
+		if f > max {
+			max = f
+		}
+	}
+	return max, true
+}
+
+// Variance returns the population variance of the numeric elements of
+// items, or 0 if items has no numeric elements.
+func Variance(items []interface{}) float64 {
+	vs := values(items)
+	if len(vs) == 0 {
+		return 0
+	}
+
+	mean := Mean(items)
+	var sq float64
+	for _, f := range vs {
+		d := f - mean
+		sq += d * d
+	}
+	return sq / float64(len(vs))
+}
+
+// StdDev returns the population standard deviation of the numeric
+// elements of items.
+func StdDev(items []interface{}) float64 {
+	return math.Sqrt(Variance(items))
+}
+
+// Median returns the median of the numeric elements of items, or 0 if
+// items has no numeric elements.
+func Median(items []interface{}) float64 {
+	vs := values(items)
+	if len(vs) == 0 {
+		return 0
+	}
+
+	sort.Float64s(vs)
+	mid := len(vs) / 2
+	if len(vs)%2 == 0 {
+		return (vs[mid-1] + vs[mid]) / 2
+	}
+	return vs[mid]
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of the numeric
+// elements of items, using linear interpolation between closest ranks.
+// It returns 0 if items has no numeric elements.
+func Percentile(items []interface{}, p float64) float64 {
+	vs := values(items)
+	if len(vs) == 0 {
+		return 0
+	}
+
+	sort.Float64s(vs)
+	if len(vs) == 1 {
+		return vs[0]
+	}
+
+	rank := (p / 100) * float64(len(vs)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo < 0 {
+		lo = 0
+	}
+	if hi >= len(vs) {
+		hi = len(vs) - 1
+	}
+	if lo == hi {
+		return vs[lo]
+	}
+
+	frac := rank - float64(lo)
+	return vs[lo] + (vs[hi]-vs[lo])*frac
+}
+
+// Summarize computes every scalar aggregate over the numeric elements of
+// items in a single pass, ignoring non-numeric elements.
+func Summarize(items []interface{}) Summary {
+	vs := values(items)
+	s := Summary{Count: len(vs)}
+	if len(vs) == 0 {
+		return s
+	}
+
+	s.Min, s.Max = vs[0], vs[0]
+	for _, f := range vs {
+		s.Sum += f
+		if f < s.Min {
+			s.Min = f
+		}
+		if f > s.Max {
+			s.Max = f
+		}
+	}
+	s.Mean = s.Sum / float64(len(vs))
+
+	var sq float64
+	for _, f := range vs {
+		d := f - s.Mean
+		sq += d * d
+	}
+	s.Variance = sq / float64(len(vs))
+	s.StdDev = math.Sqrt(s.Variance)
+
+	sorted := append([]float64(nil), vs...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		s.Median = (sorted[mid-1] + sorted[mid]) / 2
+	} else {
+		s.Median = sorted[mid]
+	}
+
+	return s
+}
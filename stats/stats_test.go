@@ -0,0 +1,172 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSumMean(t *testing.T) {
+	items := []interface{}{1, 2, 3, "ignored", 4.0}
+
+	if got := Sum(items); !approxEqual(got, 10) {
+		t.Errorf("Sum = %v, want 10", got)
+	}
+	if got := Mean(items); !approxEqual(got, 2.5) {
+		t.Errorf("Mean = %v, want 2.5", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	items := []interface{}{3, 1, "x", 4, 1.5}
+
+	min, ok := Min(items)
+	if !ok || !approxEqual(min, 1) {
+		t.Errorf("Min = (%v, %v), want (1, true)", min, ok)
+	}
+
+	max, ok := Max(items)
+	if !ok || !approxEqual(max, 4) {
+		t.Errorf("Max = (%v, %v), want (4, true)", max, ok)
+	}
+
+	if _, ok := Min(nil); ok {
+		t.Error("Min(nil) ok = true, want false")
+	}
+}
+
+func TestVariance(t *testing.T) {
+	// Population variance of 2, 4, 4, 4, 5, 5, 7, 9 is 4.
+	items := []interface{}{2, 4, 4, 4, 5, 5, 7, 9}
+
+	if got := Variance(items); !approxEqual(got, 4) {
+		t.Errorf("Variance = %v, want 4", got)
+	}
+	if got := StdDev(items); !approxEqual(got, 2) {
+		t.Errorf("StdDev = %v, want 2", got)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	if got := Median([]interface{}{1, 2, 3}); !approxEqual(got, 2) {
+		t.Errorf("Median(odd) = %v, want 2", got)
+	}
+	if got := Median([]interface{}{1, 2, 3, 4}); !approxEqual(got, 2.5) {
+		t.Errorf("Median(even) = %v, want 2.5", got)
+	}
+	if got := Median(nil); got != 0 {
+		t.Errorf("Median(nil) = %v, want 0", got)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, 5}
+
+	if got := Percentile(items, 0); !approxEqual(got, 1) {
+		t.Errorf("Percentile(0) = %v, want 1", got)
+	}
+	if got := Percentile(items, 100); !approxEqual(got, 5) {
+		t.Errorf("Percentile(100) = %v, want 5", got)
+	}
+	if got := Percentile(items, 50); !approxEqual(got, 3) {
+		t.Errorf("Percentile(50) = %v, want 3", got)
+	}
+	// rank = 0.25*4 = 1 -> exact index 1 -> value 2
+	if got := Percentile(items, 25); !approxEqual(got, 2) {
+		t.Errorf("Percentile(25) = %v, want 2", got)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, "skip"}
+
+	s := Summarize(items)
+	if s.Count != 4 {
+		t.Errorf("Count = %d, want 4", s.Count)
+	}
+	if !approxEqual(s.Sum, 10) {
+		t.Errorf("Sum = %v, want 10", s.Sum)
+	}
+	if !approxEqual(s.Mean, 2.5) {
+		t.Errorf("Mean = %v, want 2.5", s.Mean)
+	}
+	if !approxEqual(s.Min, 1) || !approxEqual(s.Max, 4) {
+		t.Errorf("Min/Max = %v/%v, want 1/4", s.Min, s.Max)
+	}
+	if !approxEqual(s.Median, 2.5) {
+		t.Errorf("Median = %v, want 2.5", s.Median)
+	}
+}
+
+func TestEWMAWarmupClampsToOne(t *testing.T) {
+	e0 := NewEWMA(2, 0)
+	e1 := NewEWMA(2, 1)
+
+	for _, x := range []float64{10, 20, 30} {
+		e0.Add(x)
+		e1.Add(x)
+	}
+
+	if !approxEqual(e0.Value(), e1.Value()) {
+		t.Errorf("warmup=0 diverged from warmup=1: %v vs %v", e0.Value(), e1.Value())
+	}
+}
+
+func TestEWMASeedsFromFirstSample(t *testing.T) {
+	e := NewEWMA(2, 1)
+
+	e.Add(42)
+	if !approxEqual(e.Value(), 42) {
+		t.Errorf("Value() after first sample = %v, want 42 (seeded)", e.Value())
+	}
+	if !e.Valid() {
+		t.Error("Valid() = false after the single warmup=1 sample, want true")
+	}
+}
+
+func TestEWMATransitionsToExponentialRecurrence(t *testing.T) {
+	// alpha = 2/(N+1) with N=3 -> alpha = 0.5
+	e := NewEWMA(3, 1)
+
+	e.Add(10) // seeds avg = 10
+	if !e.Valid() {
+		t.Error("Valid() = false after warmup sample, want true")
+	}
+
+	e.Add(20) // avg = 10 + 0.5*(20-10) = 15
+	if !approxEqual(e.Value(), 15) {
+		t.Errorf("Value() after transition sample = %v, want 15", e.Value())
+	}
+}
+
+func TestEWMAMultiSampleWarmup(t *testing.T) {
+	e := NewEWMA(3, 3)
+
+	e.Add(10)
+	e.Add(20)
+	if e.Valid() {
+		t.Error("Valid() = true before the 3-sample warmup completes, want false")
+	}
+
+	e.Add(30)
+	if !e.Valid() {
+		t.Error("Valid() = false once the 3-sample warmup completes, want true")
+	}
+	if !approxEqual(e.Value(), 20) {
+		t.Errorf("Value() after 3-sample warmup = %v, want 20 (arithmetic mean)", e.Value())
+	}
+
+	e.Add(40) // alpha = 0.5 -> avg = 20 + 0.5*(40-20) = 30
+	if !e.Valid() {
+		t.Error("Valid() = false after warmup completes, want true")
+	}
+	if !approxEqual(e.Value(), 30) {
+		t.Errorf("Value() after transition sample = %v, want 30", e.Value())
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+
+// Package typed provides generics-backed views and combinators over
+// mixed.List, so callers can work with "just the strings" or "just the
+// ints" in a mixed-type list without hand-rolled type switches.
+package typed
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/HOWZ1T/MixedLists"
+)
+
+// config holds the resolved options for Map and Reduce.
+type config struct {
+	strict bool
+}
+
+// Option configures the behavior of Map and Reduce when they encounter a
+// list item that isn't assignable to the type being operated over.
+type Option func(*config)
+
+// Strict makes Map and Reduce return an error on the first item that
+// isn't assignable to T, instead of silently skipping it.
+func Strict() Option {
+	return func(c *config) {
+		c.strict = true
+	}
+}
+
+// isInterface reports whether T is an interface type (including any),
+// using a pointer to T to recover that information even when T itself is
+// boxed into an interface{} by reflect.TypeOf.
+func isInterface[T any]() bool {
+	var zero T
+	return reflect.TypeOf(&zero).Elem().Kind() == reflect.Interface
+}
+
+// asT asserts v to T. A plain type assertion (v.(T)) always reports
+// ok=false for a nil v, even when T is an interface type like any that
+// nil trivially satisfies - Go's spec requires x to have a dynamic type
+// for the assertion to hold. asT special-cases that so a stored nil
+// isn't silently dropped when T is (or can hold) an interface.
+func asT[T any](v interface{}) (T, bool) {
+	if v == nil && isInterface[T]() {
+		var zero T
+		return zero, true
+	}
+
+	t, ok := v.(T)
+	return t, ok
+}
+
+// TypedView returns an iterator over the items of lst that are assignable
+// to T, paired with their original index in lst. Items of other types are
+// skipped.
+func TypedView[T any](lst mixed.List) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range lst.Slice() {
+			t, ok := asT[T](v)
+			if !ok {
+				continue
+			}
+			if !yield(i, t) {
+				return
+			}
+		}
+	}
+}
+
+// TypedSlice extracts every item of lst that's assignable to T into a
+// []T, preserving order. It returns an error naming the offending index
+// and type on the first item that isn't assignable to T.
+func TypedSlice[T any](lst mixed.List) ([]T, error) {
+	out := make([]T, 0, len(lst))
+	for i, v := range lst.Slice() {
+		t, ok := asT[T](v)
+		if !ok {
+			var zero T
+			return nil, fmt.Errorf("mixed/typed: TypedSlice: item at index %d is %T, not %T", i, v, zero)
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// Filter returns a new mixed.List containing only the items of lst that
+// are assignable to T.
+func Filter[T any](lst mixed.List) mixed.List {
+	out := mixed.List{}
+	for _, v := range lst.Slice() {
+		if _, ok := asT[T](v); ok {
+			out.Append(v)
+		}
+	}
+	return out
+}
+
+// Map applies fn to every item of lst assignable to T, collecting the
+// results into a new mixed.List. Items that aren't assignable to T are
+// skipped, unless Strict is given, in which case Map stops and returns an
+// error naming the offending index and type.
+func Map[T, U any](lst mixed.List, fn func(T) U, opts ...Option) (mixed.List, error) {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	out := mixed.List{}
+	for i, v := range lst.Slice() {
+		t, ok := asT[T](v)
+		if !ok {
+			if c.strict {
+				var zero T
+				return nil, fmt.Errorf("mixed/typed: Map: item at index %d is %T, not %T", i, v, zero)
+			}
+			continue
+		}
+		out.Append(fn(t))
+	}
+	return out, nil
+}
+
+// Reduce folds fn over every item of lst assignable to T, starting from
+// init. Items that aren't assignable to T are skipped, unless Strict is
+// given, in which case Reduce stops and returns an error naming the
+// offending index and type.
+func Reduce[T, A any](lst mixed.List, init A, fn func(A, T) A, opts ...Option) (A, error) {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	acc := init
+	for i, v := range lst.Slice() {
+		t, ok := asT[T](v)
+		if !ok {
+			if c.strict {
+				var zero A
+				var zeroT T
+				return zero, fmt.Errorf("mixed/typed: Reduce: item at index %d is %T, not %T", i, v, zeroT)
+			}
+			continue
+		}
+		acc = fn(acc, t)
+	}
+	return acc, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2018 Dylan Randall. All rights reserved.
+// Use of this source code is governed by a Mozilla Public License 2.0
+// license that can be found in the LICENSE file.
+package typed
+
+import (
+	"testing"
+
+	"github.com/HOWZ1T/MixedLists"
+)
+
+func TestTypedSliceHomogeneous(t *testing.T) {
+	ints, err := TypedSlice[int](mixed.List{1, 2, 3})
+	if err != nil {
+		t.Fatalf("TypedSlice[int]: %v", err)
+	}
+	if len(ints) != 3 || ints[0] != 1 || ints[1] != 2 || ints[2] != 3 {
+		t.Errorf("TypedSlice[int] = %v, want [1 2 3]", ints)
+	}
+}
+
+// TypedSlice requires every item to be assignable to T - unlike Filter,
+// it doesn't silently skip mismatches - so it errors on the first item
+// that isn't.
+func TestTypedSliceErrorsOnMismatch(t *testing.T) {
+	_, err := TypedSlice[int](mixed.List{1, "a", 2})
+	if err == nil {
+		t.Fatal("TypedSlice[int] on a mixed list = nil error, want error on the string item")
+	}
+}
+
+func TestTypedSliceNilMatchesAny(t *testing.T) {
+	lst := mixed.List{1, nil, "x"}
+
+	out, err := TypedSlice[any](lst)
+	if err != nil {
+		t.Fatalf("TypedSlice[any]: %v", err)
+	}
+	if len(out) != 3 {
+		t.Fatalf("TypedSlice[any] = %v, want 3 items", out)
+	}
+	if out[1] != nil {
+		t.Errorf("out[1] = %v, want nil", out[1])
+	}
+}
+
+func TestTypedViewNilMatchesAny(t *testing.T) {
+	lst := mixed.List{1, nil, "x"}
+
+	var idxs []int
+	for i, v := range TypedView[any](lst) {
+		idxs = append(idxs, i)
+		if i == 1 && v != nil {
+			t.Errorf("TypedView[any] yielded %v at index 1, want nil", v)
+		}
+	}
+	if len(idxs) != 3 {
+		t.Errorf("TypedView[any] yielded %d items, want 3", len(idxs))
+	}
+}
+
+func TestFilterNilMatchesAny(t *testing.T) {
+	lst := mixed.List{1, nil, "x"}
+
+	out := Filter[any](lst)
+	if len(out) != 3 {
+		t.Errorf("Filter[any] = %v, want 3 items", out)
+	}
+}
+
+func TestFilterConcreteTypeSkipsNil(t *testing.T) {
+	lst := mixed.List{1, nil, 2}
+
+	out := Filter[int](lst)
+	if len(out) != 2 {
+		t.Errorf("Filter[int] = %v, want [1 2]", out)
+	}
+}
+
+func TestMapNonStrictSkipsMismatches(t *testing.T) {
+	lst := mixed.List{1, "a", 2}
+
+	out, err := Map(lst, func(n int) int { return n * 10 })
+	if err != nil {
+		t.Fatalf("Map: %v", err)
+	}
+	if !mixed.Equal(out, mixed.List{10, 20}) {
+		t.Errorf("Map = %v, want [10 20]", out)
+	}
+}
+
+func TestMapStrictErrorsOnMismatch(t *testing.T) {
+	lst := mixed.List{1, "a", 2}
+
+	_, err := Map(lst, func(n int) int { return n * 10 }, Strict())
+	if err == nil {
+		t.Fatal("Map with Strict() = nil error, want error on the string item")
+	}
+}
+
+func TestReduceNonStrictSkipsMismatches(t *testing.T) {
+	lst := mixed.List{1, "a", 2, 3}
+
+	sum, err := Reduce(lst, 0, func(acc, n int) int { return acc + n })
+	if err != nil {
+		t.Fatalf("Reduce: %v", err)
+	}
+	if sum != 6 {
+		t.Errorf("Reduce sum = %d, want 6", sum)
+	}
+}
+
+func TestReduceStrictErrorsOnMismatch(t *testing.T) {
+	lst := mixed.List{1, "a", 2}
+
+	_, err := Reduce(lst, 0, func(acc, n int) int { return acc + n }, Strict())
+	if err == nil {
+		t.Fatal("Reduce with Strict() = nil error, want error on the string item")
+	}
+}